@@ -0,0 +1,48 @@
+// Package cgi lets gosugar users talk to local CGI executables and
+// FastCGI responders (PHP-FPM, legacy CGI scripts, ...) with the
+// same one-liner ergonomics as the gosugar HTTP client helpers.
+package cgi
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// CGIExec runs the executable at path as a CGI script: env is passed
+// as the process environment (in addition to the parent's own
+// environment, so CGI variables like REQUEST_METHOD or QUERY_STRING
+// can simply be added on top) and stdin is written to the process's
+// standard input. It returns the process's standard output as a
+// string; standard error appears only in the error returned when
+// the process fails.
+func CGIExec(path string, env map[string]string, stdin string) (string, error) {
+	cmd := exec.Command(path)
+
+	cmd.Env = os.Environ()
+	for key, value := range env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+
+	cmd.Stdin = bytes.NewBufferString(stdin)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("cgi exec %s: %w: %s", path, err, stderr.String())
+	}
+
+	return stdout.String(), nil
+}
+
+// MustCGIExec runs CGIExec and panics on error.
+func MustCGIExec(path string, env map[string]string, stdin string) string {
+	out, err := CGIExec(path, env, stdin)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
@@ -0,0 +1,280 @@
+package cgi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// Minimal FastCGI client, implementing just enough of the protocol
+// (see https://fastcgi-archives.github.io/FastCGI_Specification.html)
+// to drive a single "responder" request over TCP or a Unix socket,
+// the same thing net/http/fcgi does from the server side.
+
+const (
+	fcgiVersion1 = 1
+
+	typeBeginRequest = 1
+	typeAbortRequest = 2
+	typeEndRequest   = 3
+	typeParams       = 4
+	typeStdin        = 5
+	typeStdout       = 6
+	typeStderr       = 7
+
+	roleResponder = 1
+
+	maxRecordBody = 0xfffe // one below the uint16 content-length limit
+)
+
+type fcgiHeader struct {
+	Version       uint8
+	Type          uint8
+	RequestID     uint16
+	ContentLength uint16
+	PaddingLength uint8
+	Reserved      uint8
+}
+
+// FCGIRequest sends a single FastCGI request over network/addr
+// (e.g. "tcp", "127.0.0.1:9000" or "unix", "/run/php-fpm.sock"),
+// with params as the CGI environment (SCRIPT_FILENAME,
+// REQUEST_METHOD, ...) and stdin as the request body.
+//
+// It returns the decoded status code, response headers and body,
+// mirroring the shape of gosugar's HTTP client responses.
+func FCGIRequest(network, addr string, params map[string]string, stdin io.Reader) (int, http.Header, string, error) {
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	defer conn.Close()
+
+	const requestID = 1
+
+	if err := writeBeginRequest(conn, requestID); err != nil {
+		return 0, nil, "", err
+	}
+	if err := writeParams(conn, requestID, params); err != nil {
+		return 0, nil, "", err
+	}
+
+	var body []byte
+	if stdin != nil {
+		body, err = io.ReadAll(stdin)
+		if err != nil {
+			return 0, nil, "", err
+		}
+	}
+	if err := writeStdin(conn, requestID, body); err != nil {
+		return 0, nil, "", err
+	}
+
+	stdout, stderr, err := readFCGIResponse(conn, requestID)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	if len(stderr) > 0 {
+		return 0, nil, "", fmt.Errorf("fcgi: %s", stderr)
+	}
+
+	return parseCGIResponse(stdout)
+}
+
+// MustFCGIRequest sends a FastCGI request and panics on error.
+func MustFCGIRequest(network, addr string, params map[string]string, stdin io.Reader) (int, http.Header, string) {
+	status, headers, body, err := FCGIRequest(network, addr, params, stdin)
+	if err != nil {
+		panic(err)
+	}
+	return status, headers, body
+}
+
+// FCGIJSON sends a FastCGI request and decodes the response body as
+// JSON into type T, the FastCGI counterpart to gosugar's PostJSON.
+func FCGIJSON[T any](network, addr string, params map[string]string, stdin io.Reader) (T, error) {
+	var result T
+
+	_, _, body, err := FCGIRequest(network, addr, params, stdin)
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal([]byte(body), &result)
+	return result, err
+}
+
+// writeRecord writes content as one or more FastCGI records,
+// splitting it into chunks no larger than maxRecordBody. An empty
+// (possibly nil) content writes a single zero-length record, which
+// is how PARAMS and STDIN streams are terminated.
+func writeRecord(w io.Writer, recType uint8, requestID uint16, content []byte) error {
+	for {
+		chunk := content
+		if len(chunk) > maxRecordBody {
+			chunk = chunk[:maxRecordBody]
+		}
+
+		hdr := fcgiHeader{
+			Version:       fcgiVersion1,
+			Type:          recType,
+			RequestID:     requestID,
+			ContentLength: uint16(len(chunk)),
+		}
+
+		if err := binary.Write(w, binary.BigEndian, hdr); err != nil {
+			return err
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+
+		content = content[len(chunk):]
+		if len(content) == 0 {
+			return nil
+		}
+	}
+}
+
+func writeBeginRequest(w io.Writer, requestID uint16) error {
+	hdr := fcgiHeader{
+		Version:       fcgiVersion1,
+		Type:          typeBeginRequest,
+		RequestID:     requestID,
+		ContentLength: 8,
+	}
+	if err := binary.Write(w, binary.BigEndian, hdr); err != nil {
+		return err
+	}
+
+	body := make([]byte, 8)
+	binary.BigEndian.PutUint16(body[0:2], roleResponder)
+	body[2] = 0 // flags: don't keep the connection open
+
+	_, err := w.Write(body)
+	return err
+}
+
+func writeParams(w io.Writer, requestID uint16, params map[string]string) error {
+	var buf bytes.Buffer
+
+	for key, value := range params {
+		writeNameValue(&buf, key, value)
+	}
+
+	if err := writeRecord(w, typeParams, requestID, buf.Bytes()); err != nil {
+		return err
+	}
+	// empty PARAMS record terminates the stream
+	return writeRecord(w, typeParams, requestID, nil)
+}
+
+func writeStdin(w io.Writer, requestID uint16, data []byte) error {
+	if len(data) > 0 {
+		if err := writeRecord(w, typeStdin, requestID, data); err != nil {
+			return err
+		}
+	}
+	// empty STDIN record signals EOF
+	return writeRecord(w, typeStdin, requestID, nil)
+}
+
+// writeNameValue encodes a single FastCGI name-value pair using the
+// short (7-bit) or long (31-bit) length form as required.
+func writeNameValue(buf *bytes.Buffer, name, value string) {
+	writeLength(buf, len(name))
+	writeLength(buf, len(value))
+	buf.WriteString(name)
+	buf.WriteString(value)
+}
+
+func writeLength(buf *bytes.Buffer, n int) {
+	if n < 0x80 {
+		buf.WriteByte(byte(n))
+		return
+	}
+
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(n)|0x80000000)
+	buf.Write(b)
+}
+
+// readFCGIResponse reads records from r until the EndRequest record
+// for requestID arrives, accumulating stdout and stderr content.
+func readFCGIResponse(r io.Reader, requestID uint16) (stdout, stderr []byte, err error) {
+	br := bufio.NewReader(r)
+
+	for {
+		var hdr fcgiHeader
+		if err := binary.Read(br, binary.BigEndian, &hdr); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, nil, err
+		}
+
+		content := make([]byte, hdr.ContentLength)
+		if _, err := io.ReadFull(br, content); err != nil {
+			return nil, nil, err
+		}
+		if hdr.PaddingLength > 0 {
+			if _, err := io.CopyN(io.Discard, br, int64(hdr.PaddingLength)); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if hdr.RequestID != requestID {
+			continue
+		}
+
+		switch hdr.Type {
+		case typeStdout:
+			stdout = append(stdout, content...)
+		case typeStderr:
+			stderr = append(stderr, content...)
+		case typeEndRequest:
+			return stdout, stderr, nil
+		}
+	}
+
+	return stdout, stderr, nil
+}
+
+// parseCGIResponse splits a raw CGI/FastCGI response into its
+// status code, headers and body, following the same "Status:"
+// convention as net/http/cgi.
+func parseCGIResponse(raw []byte) (int, http.Header, string, error) {
+	tp := textproto.NewReader(bufio.NewReader(bytes.NewReader(raw)))
+
+	mimeHeader, err := tp.ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return 0, nil, "", err
+	}
+
+	header := http.Header(mimeHeader)
+
+	status := http.StatusOK
+	if s := header.Get("Status"); s != "" {
+		if fields := strings.Fields(s); len(fields) > 0 {
+			if code, convErr := strconv.Atoi(fields[0]); convErr == nil {
+				status = code
+			}
+		}
+		header.Del("Status")
+	}
+
+	rest, err := io.ReadAll(tp.R)
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	return status, header, string(rest), nil
+}
@@ -0,0 +1,36 @@
+package cgi
+
+import "testing"
+
+func TestParseCGIResponseMalformedStatus(t *testing.T) {
+	raw := []byte("Status: 5\r\nContent-Type: text/plain\r\n\r\nbody")
+
+	status, headers, body, err := parseCGIResponse(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != 5 {
+		t.Errorf("status = %d, want 5", status)
+	}
+	if headers.Get("Content-Type") != "text/plain" {
+		t.Errorf("Content-Type = %q", headers.Get("Content-Type"))
+	}
+	if body != "body" {
+		t.Errorf("body = %q, want %q", body, "body")
+	}
+}
+
+func TestParseCGIResponseNormalStatus(t *testing.T) {
+	raw := []byte("Status: 404 Not Found\r\n\r\nmissing")
+
+	status, _, body, err := parseCGIResponse(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != 404 {
+		t.Errorf("status = %d, want 404", status)
+	}
+	if body != "missing" {
+		t.Errorf("body = %q, want %q", body, "missing")
+	}
+}
@@ -0,0 +1,502 @@
+package gosugar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+//
+// RETRY POLICY
+//
+
+// RetryPolicy controls how a Client retries failed requests.
+// A request is retried when it returns a 5xx status code or
+// fails with a network error. Retries use exponential backoff,
+// starting at BaseDelay and doubling up to MaxDelay.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// delay returns the backoff duration before retry attempt n (0-indexed).
+func (p *RetryPolicy) delay(n int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 200 * time.Millisecond
+	}
+
+	d := time.Duration(float64(base) * math.Pow(2, float64(n)))
+
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	return d
+}
+
+//
+// CLIENT
+//
+
+// Client is a configurable HTTP client. The zero value is usable,
+// but BaseURL, Timeout, Headers, Transport, RetryPolicy and CookieJar
+// can all be set to customize behavior.
+type Client struct {
+	BaseURL     string
+	Timeout     time.Duration
+	Headers     http.Header
+	Transport   http.RoundTripper
+	RetryPolicy *RetryPolicy
+	CookieJar   http.CookieJar
+}
+
+// DefaultClient is the package-level Client used by the
+// top-level helper functions (GetBody, PostJSON, ...).
+var DefaultClient = NewClient()
+
+// NewClient returns a Client with sane defaults.
+func NewClient() *Client {
+	return &Client{
+		Timeout: 30 * time.Second,
+		Headers: http.Header{},
+	}
+}
+
+// httpClient builds the underlying *http.Client for a request.
+func (c *Client) httpClient() *http.Client {
+	return &http.Client{
+		Timeout:   c.Timeout,
+		Transport: c.Transport,
+		Jar:       c.CookieJar,
+	}
+}
+
+// resolve joins the client's BaseURL with path, if path is not
+// already an absolute URL.
+func (c *Client) resolve(path string) string {
+	if c.BaseURL == "" || strings.Contains(path, "://") {
+		return path
+	}
+	return strings.TrimRight(c.BaseURL, "/") + "/" + strings.TrimLeft(path, "/")
+}
+
+// NewRequest starts a fluent Request for the given method and path.
+func (c *Client) NewRequest(method, path string) *Request {
+	return &Request{
+		client:  c,
+		method:  method,
+		url:     c.resolve(path),
+		headers: http.Header{},
+		query:   url.Values{},
+	}
+}
+
+// Verb shortcuts on Client.
+
+func (c *Client) Get(path string) *Request     { return c.NewRequest(http.MethodGet, path) }
+func (c *Client) Post(path string) *Request    { return c.NewRequest(http.MethodPost, path) }
+func (c *Client) Put(path string) *Request     { return c.NewRequest(http.MethodPut, path) }
+func (c *Client) Delete(path string) *Request  { return c.NewRequest(http.MethodDelete, path) }
+func (c *Client) Head(path string) *Request    { return c.NewRequest(http.MethodHead, path) }
+func (c *Client) Patch(path string) *Request   { return c.NewRequest(http.MethodPatch, path) }
+func (c *Client) Options(path string) *Request { return c.NewRequest(http.MethodOptions, path) }
+
+//
+// REQUEST BUILDER
+//
+
+// Request is a fluent HTTP request builder produced by Client.NewRequest
+// (or one of its verb shortcuts). Builder methods return the same
+// *Request so calls can be chained.
+type Request struct {
+	client      *Client
+	method      string
+	url         string
+	headers     http.Header
+	query       url.Values
+	body        io.Reader
+	getBody     func() (io.Reader, error)
+	contentType string
+	ctx         context.Context
+	err         error
+}
+
+// Header sets a single request header.
+func (r *Request) Header(key, value string) *Request {
+	r.headers.Set(key, value)
+	return r
+}
+
+// Query adds a query string parameter.
+func (r *Request) Query(key, value string) *Request {
+	r.query.Set(key, value)
+	return r
+}
+
+// BasicAuth sets the Authorization header to use HTTP basic auth.
+func (r *Request) BasicAuth(user, pass string) *Request {
+	r.headers.Set("Authorization", "Basic "+basicAuthToken(user, pass))
+	return r
+}
+
+// BearerToken sets the Authorization header to use a bearer token.
+func (r *Request) BearerToken(token string) *Request {
+	r.headers.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+// JSON encodes payload as the request body and sets
+// Content-Type: application/json.
+func (r *Request) JSON(payload any) *Request {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		r.err = err
+		return r
+	}
+	r.getBody = func() (io.Reader, error) { return bytes.NewReader(data), nil }
+	r.contentType = "application/json"
+	return r
+}
+
+// Form encodes values as a urlencoded request body and sets
+// Content-Type: application/x-www-form-urlencoded.
+func (r *Request) Form(values url.Values) *Request {
+	encoded := values.Encode()
+	r.getBody = func() (io.Reader, error) { return strings.NewReader(encoded), nil }
+	r.contentType = "application/x-www-form-urlencoded"
+	return r
+}
+
+// Multipart builds a multipart/form-data request body from a set of
+// plain fields and a set of files (field name -> file path on disk).
+func (r *Request) Multipart(fields map[string]string, files map[string]string) *Request {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			r.err = err
+			return r
+		}
+	}
+
+	for field, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			r.err = err
+			return r
+		}
+
+		part, err := writer.CreateFormFile(field, filepath.Base(path))
+		if err != nil {
+			f.Close()
+			r.err = err
+			return r
+		}
+
+		if _, err := io.Copy(part, f); err != nil {
+			f.Close()
+			r.err = err
+			return r
+		}
+		f.Close()
+	}
+
+	if err := writer.Close(); err != nil {
+		r.err = err
+		return r
+	}
+
+	data := buf.Bytes()
+	r.getBody = func() (io.Reader, error) { return bytes.NewReader(data), nil }
+	r.contentType = writer.FormDataContentType()
+	return r
+}
+
+// Context attaches ctx to the outgoing request.
+func (r *Request) Context(ctx context.Context) *Request {
+	r.ctx = ctx
+	return r
+}
+
+// Send performs the request, retrying according to the client's
+// RetryPolicy on 5xx responses or network errors, and returns
+// the Response. Any 2xx status code is treated as success.
+func (r *Request) Send() (*Response, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	policy := r.client.RetryPolicy
+	attempts := 1
+	if policy != nil && policy.MaxRetries > 0 {
+		attempts += policy.MaxRetries
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(policy.delay(attempt - 1))
+		}
+
+		resp, err := r.do()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.resp.StatusCode >= 500 && attempt < attempts-1 {
+			drainAndClose(resp.resp)
+			lastErr = fmt.Errorf("status code: %d", resp.resp.StatusCode)
+			continue
+		}
+
+		if resp.resp.StatusCode < 200 || resp.resp.StatusCode >= 300 {
+			err := fmt.Errorf("status code: %d", resp.resp.StatusCode)
+			drainAndClose(resp.resp)
+			return resp, err
+		}
+
+		return resp, nil
+	}
+
+	return nil, lastErr
+}
+
+// drainAndClose discards any remaining response body and closes it,
+// so the underlying connection can be reused, for responses that
+// are being abandoned (retried or returned as a non-2xx error).
+func drainAndClose(resp *http.Response) {
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// do builds and executes a single attempt of the HTTP request.
+func (r *Request) do() (*Response, error) {
+	reqURL := r.url
+	if len(r.query) > 0 {
+		if strings.Contains(reqURL, "?") {
+			reqURL += "&" + r.query.Encode()
+		} else {
+			reqURL += "?" + r.query.Encode()
+		}
+	}
+
+	body := r.body
+	if r.getBody != nil {
+		b, err := r.getBody()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	req, err := http.NewRequest(r.method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.ctx != nil {
+		req = req.WithContext(r.ctx)
+	}
+
+	for key, values := range r.client.Headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	for key, values := range r.headers {
+		for _, v := range values {
+			req.Header.Set(key, v)
+		}
+	}
+	if r.contentType != "" {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+
+	resp, err := r.client.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{resp: resp}, nil
+}
+
+// basicAuthToken base64-encodes "user:pass" for the Authorization header.
+func basicAuthToken(user, pass string) string {
+	req := &http.Request{Header: http.Header{}}
+	req.SetBasicAuth(user, pass)
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Basic ")
+}
+
+//
+// RESPONSE
+//
+
+// Response wraps an *http.Response with convenience accessors.
+// The body is read lazily and cached on first access by String,
+// JSON or Bytes; use Stream to read it without buffering.
+type Response struct {
+	resp *http.Response
+	body []byte
+	read bool
+}
+
+// load reads and caches the response body.
+func (r *Response) load() error {
+	if r.read {
+		return nil
+	}
+	defer r.resp.Body.Close()
+
+	data, err := io.ReadAll(r.resp.Body)
+	if err != nil {
+		return err
+	}
+
+	r.body = data
+	r.read = true
+	return nil
+}
+
+// String returns the response body as a string.
+func (r *Response) String() string {
+	if err := r.load(); err != nil {
+		return ""
+	}
+	return string(r.body)
+}
+
+// Bytes returns the response body as raw bytes.
+func (r *Response) Bytes() []byte {
+	if err := r.load(); err != nil {
+		return nil
+	}
+	return r.body
+}
+
+// JSON decodes the response body as JSON into v.
+func (r *Response) JSON(v any) error {
+	if err := r.load(); err != nil {
+		return err
+	}
+	return json.Unmarshal(r.body, v)
+}
+
+// Stream returns the raw response body for callers that want to
+// read it themselves instead of buffering it via String/JSON/Bytes.
+// The caller is responsible for closing it.
+func (r *Response) Stream() io.ReadCloser {
+	return r.resp.Body
+}
+
+// StatusCode returns the HTTP status code of the response.
+func (r *Response) StatusCode() int {
+	return r.resp.StatusCode
+}
+
+// Headers returns the response headers.
+func (r *Response) Headers() http.Header {
+	return r.resp.Header
+}
+
+//
+// HEAD / PATCH / OPTIONS (package level, mirrors GetBody/PostBody style)
+//
+
+// Head sends an HTTP HEAD request and returns the response headers.
+func Head(url string) (http.Header, error) {
+	resp, err := DefaultClient.Head(url).Send()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Headers(), nil
+}
+
+// MustHead sends an HTTP HEAD request and panics on error.
+func MustHead(url string) http.Header {
+	return Must(Head(url))
+}
+
+// PatchBody sends an HTTP PATCH request with the provided body and
+// content type, and returns the response body as a string.
+func PatchBody(url string, body io.Reader, contentType string) (string, error) {
+	resp, err := DefaultClient.Patch(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
+	if err != nil {
+		return "", err
+	}
+	return resp.String(), nil
+}
+
+// MustPatchBody sends an HTTP PATCH request and panics on error.
+func MustPatchBody(url string, body io.Reader, contentType string) string {
+	return Must(PatchBody(url, body, contentType))
+}
+
+// PatchJSON sends an HTTP PATCH request with a JSON payload
+// and decodes the JSON response into type T.
+func PatchJSON[T any](url string, payload any) (T, error) {
+	var result T
+
+	resp, err := DefaultClient.Patch(url).JSON(payload).Send()
+	if err != nil {
+		return result, err
+	}
+
+	err = resp.JSON(&result)
+	return result, err
+}
+
+// HeadJSON sends an HTTP HEAD request and decodes a JSON-shaped
+// header value (e.g. a custom "X-Meta" header) into type T.
+// It exists to mirror GetJSON/PostJSON for callers that encode
+// metadata in headers rather than the body.
+func HeadJSON[T any](url string, header string) (T, error) {
+	var result T
+
+	headers, err := Head(url)
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal([]byte(headers.Get(header)), &result)
+	return result, err
+}
+
+// Options sends an HTTP OPTIONS request and returns the response headers.
+func Options(url string) (http.Header, error) {
+	resp, err := DefaultClient.Options(url).Send()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Headers(), nil
+}
+
+// MustOptions sends an HTTP OPTIONS request and panics on error.
+func MustOptions(url string) http.Header {
+	return Must(Options(url))
+}
+
+// bodyReader is a small helper used by the PATCH wrapper above and
+// the POST/PUT/DELETE wrappers in http.go to attach a pre-read body
+// without going through JSON/Form/Multipart.
+func (r *Request) bodyReader(body io.Reader) *Request {
+	r.body = body
+	return r
+}
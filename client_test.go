@@ -0,0 +1,113 @@
+package gosugar
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// trackingBody wraps a response body and records whether Close was called.
+type trackingBody struct {
+	io.ReadCloser
+	closed bool
+}
+
+func (t *trackingBody) Close() error {
+	t.closed = true
+	return t.ReadCloser.Close()
+}
+
+type trackingTransport struct {
+	body *trackingBody
+}
+
+func (t *trackingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	t.body = &trackingBody{ReadCloser: resp.Body}
+	resp.Body = t.body
+	return resp, nil
+}
+
+func TestRequestSendClosesBodyOnNon2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}))
+	defer srv.Close()
+
+	tr := &trackingTransport{}
+	client := NewClient()
+	client.Transport = tr
+
+	_, err := client.Get(srv.URL).Send()
+	if err == nil {
+		t.Fatal("expected an error for a 404 response")
+	}
+	if tr.body == nil {
+		t.Fatal("transport never saw a response body")
+	}
+	if !tr.body.closed {
+		t.Error("response body was not closed on a non-2xx response")
+	}
+}
+
+func TestRequestSendOK(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	resp, err := NewClient().Get(srv.URL).Send()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.String() != "hello" {
+		t.Errorf("got body %q, want %q", resp.String(), "hello")
+	}
+}
+
+// TestRequestSendResendsBodyOnRetry ensures a retried POST/PUT/PATCH
+// with a JSON/Form/Multipart body sends the same body on every
+// attempt, not an empty one after the first (now-drained) read.
+func TestRequestSendResendsBodyOnRetry(t *testing.T) {
+	var bodies []string
+	attempt := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+
+		attempt++
+		if attempt == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := NewClient()
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}
+
+	resp, err := client.Post(srv.URL).JSON(map[string]string{"hello": "world"}).Send()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode())
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("server saw %d requests, want 2", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"hello":"world"}` {
+			t.Errorf("attempt %d body = %q, want %q", i+1, body, `{"hello":"world"}`)
+		}
+	}
+}
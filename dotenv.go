@@ -0,0 +1,193 @@
+package gosugar
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ParseEnv reads dotenv-formatted content from r and returns its
+// key/value pairs, without touching the process environment. It
+// understands the common .env dialect: single/double-quoted values
+// (with \n, \t and \" escapes recognized only inside double
+// quotes), unquoted values with a trailing "# comment" stripped,
+// an optional "export " prefix, multi-line values inside quotes,
+// and ${VAR}/$VAR/${VAR:-default} expansion resolved against keys
+// parsed earlier in the same file and the process environment.
+func ParseEnv(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseEnvContent(string(data))
+}
+
+var envVarPattern = regexp.MustCompile(`\$\{(\w+)(:-([^}]*))?\}|\$(\w+)`)
+
+func parseEnvContent(s string) (map[string]string, error) {
+	result := map[string]string{}
+	pos := 0
+
+	for pos < len(s) {
+		for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t' || s[pos] == '\r' || s[pos] == '\n') {
+			pos++
+		}
+		if pos >= len(s) {
+			break
+		}
+
+		if s[pos] == '#' {
+			pos = skipLine(s, pos)
+			continue
+		}
+
+		eq := strings.IndexByte(s[pos:], '=')
+		nl := strings.IndexByte(s[pos:], '\n')
+		if eq == -1 || (nl != -1 && nl < eq) {
+			return nil, fmt.Errorf("invalid env line: %q", currentLine(s, pos))
+		}
+
+		key := strings.TrimSpace(s[pos : pos+eq])
+		if rest := strings.TrimPrefix(key, "export"); rest != key && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			key = strings.TrimSpace(rest)
+		}
+		if key == "" {
+			return nil, fmt.Errorf("invalid env line: %q", currentLine(s, pos))
+		}
+
+		pos += eq + 1
+
+		value, newPos, err := parseEnvValue(s, pos, result)
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = value
+		pos = newPos
+	}
+
+	return result, nil
+}
+
+// parseEnvValue parses the value starting at pos (right after the
+// '='), returning the decoded value and the position just past it.
+func parseEnvValue(s string, pos int, known map[string]string) (string, int, error) {
+	if pos < len(s) && (s[pos] == '"' || s[pos] == '\'') {
+		quote := s[pos]
+		pos++
+
+		var buf strings.Builder
+		for pos < len(s) && s[pos] != quote {
+			if quote == '"' && s[pos] == '\\' && pos+1 < len(s) {
+				switch s[pos+1] {
+				case 'n':
+					buf.WriteByte('\n')
+					pos += 2
+					continue
+				case 't':
+					buf.WriteByte('\t')
+					pos += 2
+					continue
+				case '"':
+					buf.WriteByte('"')
+					pos += 2
+					continue
+				case '\\':
+					buf.WriteByte('\\')
+					pos += 2
+					continue
+				}
+			}
+			buf.WriteByte(s[pos])
+			pos++
+		}
+
+		if pos >= len(s) {
+			return "", pos, fmt.Errorf("unterminated quoted value: %q", currentLine(s, pos))
+		}
+		pos++ // skip closing quote
+
+		value := buf.String()
+		if quote == '"' {
+			value = expandEnvVars(value, known)
+		}
+
+		return value, skipLine(s, pos), nil
+	}
+
+	lineEnd := strings.IndexByte(s[pos:], '\n')
+	var raw string
+	var next int
+	if lineEnd == -1 {
+		raw = s[pos:]
+		next = len(s)
+	} else {
+		raw = s[pos : pos+lineEnd]
+		next = pos + lineEnd + 1
+	}
+
+	if idx := commentIndex(raw); idx != -1 {
+		raw = raw[:idx]
+	}
+
+	value := expandEnvVars(strings.TrimSpace(raw), known)
+	return value, next, nil
+}
+
+// commentIndex returns the index of the first '#' that starts a
+// trailing comment in an unquoted value: one preceded by whitespace,
+// or at the very start of the value. A '#' embedded in the value
+// itself (e.g. a URL fragment) is left alone.
+func commentIndex(raw string) int {
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '#' {
+			continue
+		}
+		if i == 0 || raw[i-1] == ' ' || raw[i-1] == '\t' {
+			return i
+		}
+	}
+	return -1
+}
+
+// expandEnvVars resolves ${VAR}, ${VAR:-default} and $VAR references
+// in value, preferring keys already parsed from the same file over
+// the process environment.
+func expandEnvVars(value string, known map[string]string) string {
+	return envVarPattern.ReplaceAllStringFunc(value, func(match string) string {
+		groups := envVarPattern.FindStringSubmatch(match)
+
+		name := groups[1]
+		if name == "" {
+			name = groups[4]
+		}
+
+		if v, ok := known[name]; ok {
+			return v
+		}
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		if groups[2] != "" {
+			return groups[3]
+		}
+		return ""
+	})
+}
+
+func skipLine(s string, pos int) int {
+	if idx := strings.IndexByte(s[pos:], '\n'); idx != -1 {
+		return pos + idx + 1
+	}
+	return len(s)
+}
+
+func currentLine(s string, pos int) string {
+	end := pos
+	for end < len(s) && s[end] != '\n' {
+		end++
+	}
+	return strings.TrimSpace(s[pos:end])
+}
@@ -0,0 +1,45 @@
+package gosugar
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseEnvURLFragmentIsNotAComment(t *testing.T) {
+	values, err := ParseEnv(strings.NewReader("URL=http://example.com/#fragment\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "http://example.com/#fragment"
+	if values["URL"] != want {
+		t.Errorf("URL = %q, want %q", values["URL"], want)
+	}
+}
+
+func TestParseEnvTrailingComment(t *testing.T) {
+	values, err := ParseEnv(strings.NewReader("FOO=bar # a comment\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if values["FOO"] != "bar" {
+		t.Errorf("FOO = %q, want %q", values["FOO"], "bar")
+	}
+}
+
+func TestParseEnvQuotingAndExpansion(t *testing.T) {
+	content := "export BASE=\"hello\\nworld\"\nDERIVED=${BASE}!\nFALLBACK=${MISSING:-default}\n"
+
+	values, err := ParseEnv(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if values["BASE"] != "hello\nworld" {
+		t.Errorf("BASE = %q", values["BASE"])
+	}
+	if values["FALLBACK"] != "default" {
+		t.Errorf("FALLBACK = %q, want %q", values["FALLBACK"], "default")
+	}
+}
@@ -1,7 +1,6 @@
 package gosugar
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"strconv"
@@ -12,44 +11,92 @@ import (
 // ENV FILE LOADER
 //
 
+// EnvOptions controls how LoadEnv applies parsed values to the
+// process environment.
+type EnvOptions struct {
+	// Override, when true, overwrites variables that are already
+	// set in the process environment. By default existing
+	// variables win, matching the long-standing EnvFile behavior.
+	Override bool
+}
+
+// EnvFile loads a .env file and applies its values to the process
+// environment, without overriding variables that are already set.
+// It panics if the file cannot be read or parsed.
 func EnvFile(path string) {
-	file, err := os.Open(path)
-	if err != nil {
-		panic(fmt.Errorf("cannot open env file: %s", path))
+	if err := LoadEnv(path, EnvOptions{}); err != nil {
+		panic(err)
 	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
+}
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
+// EnvFileOverride loads a .env file like EnvFile, but overwrites
+// variables that are already set in the process environment.
+func EnvFileOverride(path string) {
+	if err := LoadEnv(path, EnvOptions{Override: true}); err != nil {
+		panic(err)
+	}
+}
 
-		// boş satır veya yorum
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
+// EnvFiles loads multiple .env files in order, with values from
+// later files overriding values from earlier ones. Variables
+// already present in the process environment still win, matching
+// EnvFile's default behavior.
+func EnvFiles(paths ...string) {
+	merged := map[string]string{}
+
+	for _, path := range paths {
+		values, err := EnvMap(path)
+		if err != nil {
+			panic(err)
 		}
-
-		key, value, ok := strings.Cut(line, "=")
-		if !ok {
-			panic(fmt.Errorf("invalid env line: %q", line))
+		for key, value := range values {
+			merged[key] = value
 		}
+	}
 
-		key = strings.TrimSpace(key)
-		value = strings.TrimSpace(value)
-
-		// varsa override etme
+	for key, value := range merged {
 		if _, exists := os.LookupEnv(key); exists {
 			continue
 		}
-
 		if err := os.Setenv(key, value); err != nil {
 			panic(fmt.Errorf("failed to set env %s", key))
 		}
 	}
+}
 
-	if err := scanner.Err(); err != nil {
-		panic(err)
+// EnvMap parses a .env file and returns its key/value pairs without
+// touching the process environment.
+func EnvMap(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open env file: %s", path)
+	}
+	defer file.Close()
+
+	return ParseEnv(file)
+}
+
+// LoadEnv parses a .env file and applies its values to the process
+// environment according to opts, returning an error instead of
+// panicking on failure.
+func LoadEnv(path string, opts EnvOptions) error {
+	values, err := EnvMap(path)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range values {
+		if !opts.Override {
+			if _, exists := os.LookupEnv(key); exists {
+				continue
+			}
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("failed to set env %s", key)
+		}
 	}
+
+	return nil
 }
 
 //
@@ -1,69 +1,43 @@
 package gosugar
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 )
 
+// This file keeps the original one-liner HTTP helpers around for
+// backward compatibility. They are now thin wrappers over
+// DefaultClient and the Request/Response builder in client.go,
+// so they share the same retry policy, timeout and headers as any
+// other code built on top of Client.
+
 // GET requests
 
 // GetBody sends an HTTP GET request to the given URL
 // and returns the response body as a string.
 //
 // It returns an error if the request fails,
-// the response status code is not 200 OK,
-// or the response body cannot be read.
+// the response status is not a 2xx, or the response
+// body cannot be read.
 func GetBody(url string) (string, error) {
-	// Send an HTTP GET request to the given URL
-	resp, err := http.Get(url)
-
-	// Return an error if the request fails
+	resp, err := DefaultClient.Get(url).Send()
 	if err != nil {
 		return "", err
 	}
-
-	// Ensure the response body is closed
-	// when the function exits
-	defer resp.Body.Close()
-
-	// Treat non-200 OK status codes as errors
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	// Read the entire response body
-	body, err := io.ReadAll(resp.Body)
-
-	// Return an error if reading the body fails
-	if err != nil {
+	if err := resp.load(); err != nil {
 		return "", err
 	}
-
-	// Convert body from bytes to string and return it
-	return string(body), nil
+	return string(resp.body), nil
 }
 
 // MustGetBody sends an HTTP GET request to the given URL
 // and returns the response body as a string.
 //
-// It panics if the request fails, the status code is not 200 OK,
+// It panics if the request fails, the status is not a 2xx,
 // or the response body cannot be read.
 // Use this function when a failure should stop the program.
 func MustGetBody(url string) string {
-	body, err := GetBody(url)
-
-	if err != nil {
-		panic(err)
-	}
-
-	// Return body
-	return body
+	return Must(GetBody(url))
 }
 
 // GetJSON sends an HTTP GET request to the given URL
@@ -71,84 +45,45 @@ func MustGetBody(url string) string {
 //
 // The type T is a generic type parameter, allowing the caller
 // to define the expected response structure at compile time.
-//
-// This function returns an error if:
-// - the HTTP request fails
-// - the response status code is not successful (handled in GetBody)
-// - the response body cannot be read
-// - the JSON cannot be unmarshaled into type T
 func GetJSON[T any](url string) (T, error) {
-	// Declare a zero-value variable of type T.
-	// This will be returned in case of an error.
 	var result T
 
-	// Perform an HTTP GET request and retrieve the response body as a string
-	body, err := GetBody(url)
+	resp, err := DefaultClient.Get(url).Send()
 	if err != nil {
-		// Return the zero-value result along with the error
 		return result, err
 	}
 
-	// Convert the response body (string) into a byte slice
-	// and unmarshal the JSON data into the result variable
-	err = json.Unmarshal([]byte(body), &result)
-	if err != nil {
-		// Return the zero-value result if JSON decoding fails
-		return result, err
-	}
+	err = resp.JSON(&result)
+	return result, err
+}
 
-	// Return the successfully decoded result
-	// and a nil error to indicate success
-	return result, nil
+// MustGetJSON sends an HTTP GET request and decodes the JSON
+// response into type T, panicking on any error.
+func MustGetJSON[T any](url string) T {
+	return Must(GetJSON[T](url))
 }
 
 // GetHeader sends an HTTP GET request to the given URL
 // and returns the response headers.
 //
 // It returns an error if the request fails
-// or the response status code is not 200 OK.
+// or the response status is not a 2xx.
 func GetHeader(url string) (http.Header, error) {
-	// Send an HTTP GET request to the given URL
-	resp, err := http.Get(url)
-
-	// Return an error if the request fails
+	resp, err := DefaultClient.Get(url).Send()
 	if err != nil {
 		return nil, err
 	}
-
-	// Ensure the response body is closed
-	// even though the body is not read
-	defer resp.Body.Close()
-
-	// Treat non-200 OK status codes as errors
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	// Return the response headers
-	// http.Header is a map[string][]string
-	// and is case-insensitive by design
-	return resp.Header, nil
+	return resp.Headers(), nil
 }
 
 // MustGetHeader sends an HTTP GET request to the given URL
 // and returns the response headers.
 //
 // It panics if the request fails or the response
-// status code is not 200 OK.
+// status is not a 2xx.
 // Use this function when a failure should stop the program.
 func MustGetHeader(url string) http.Header {
-	headers, err := GetHeader(url)
-
-	// Panic if an error occurs
-	if err != nil {
-		panic(err)
-	}
-
-	return headers
+	return Must(GetHeader(url))
 }
 
 // POST requests
@@ -157,98 +92,58 @@ func MustGetHeader(url string) http.Header {
 // with the provided body and content type,
 // and returns the response body as a string.
 func PostBody(url string, body io.Reader, contentType string) (string, error) {
-	resp, err := http.Post(url, contentType, body)
-
+	resp, err := DefaultClient.Post(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
 	if err != nil {
 		return "", err
 	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	respBody, err := io.ReadAll(resp.Body)
-
-	if err != nil {
-		return "", err
-	}
-
-	return string(respBody), nil
-
+	return resp.String(), nil
 }
 
 // MustPostBody sends an HTTP POST request and panics on error.
 func MustPostBody(url string, body io.Reader, contentType string) string {
-	result, err := PostBody(url, body, contentType)
-
-	if err != nil {
-		panic(err)
-	}
-
-	return result
+	return Must(PostBody(url, body, contentType))
 }
 
 // PostJSON sends an HTTP POST request with a JSON payload
 // and decodes the JSON response into type T.
-// and decodes the JSON response into type T.
 func PostJSON[T any](url string, payload any) (T, error) {
 	var result T
 
-	// Encode payload to JSON
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return result, err
-	}
-
-	respBody, err := PostBody(
-		url,
-		bytes.NewReader(data),
-		"application/json",
-	)
+	resp, err := DefaultClient.Post(url).JSON(payload).Send()
 	if err != nil {
 		return result, err
 	}
 
-	err = json.Unmarshal([]byte(respBody), &result)
-	if err != nil {
-		return result, err
-	}
+	err = resp.JSON(&result)
+	return result, err
+}
 
-	return result, nil
+// MustPostJSON sends an HTTP POST request with a JSON payload and
+// decodes the JSON response into type T, panicking on any error.
+func MustPostJSON[T any](url string, payload any) T {
+	return Must(PostJSON[T](url, payload))
 }
 
 // PostHeader sends an HTTP POST request
 // and returns the response headers.
 func PostHeader(url string, body io.Reader, contentType string) (http.Header, error) {
-	resp, err := http.Post(url, contentType, body)
+	resp, err := DefaultClient.Post(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	return resp.Header, nil
+	return resp.Headers(), nil
 }
 
 // MustPostHeader sends an HTTP POST request
 // and panics on error.
 func MustPostHeader(url string, body io.Reader, contentType string) http.Header {
-	headers, err := PostHeader(url, body, contentType)
-	if err != nil {
-		panic(err)
-	}
-	return headers
+	return Must(PostHeader(url, body, contentType))
 }
 
 // PUT requests
@@ -257,45 +152,19 @@ func MustPostHeader(url string, body io.Reader, contentType string) http.Header
 // with the provided body and content type,
 // and returns the response body as a string.
 func PutBody(url string, body io.Reader, contentType string) (string, error) {
-	req, err := http.NewRequest("PUT", url, body)
+	resp, err := DefaultClient.Put(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
 	if err != nil {
 		return "", err
 	}
-
-	req.Header.Set("Content-Type", contentType)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	return string(respBody), nil
+	return resp.String(), nil
 }
 
 // MustPutBody sends an HTTP PUT request and panics on error.
 func MustPutBody(url string, body io.Reader, contentType string) string {
-	result, err := PutBody(url, body, contentType)
-
-	if err != nil {
-		panic(err)
-	}
-
-	return result
+	return Must(PutBody(url, body, contentType))
 }
 
 // PutJSON sends an HTTP PUT request with a JSON payload
@@ -303,65 +172,32 @@ func MustPutBody(url string, body io.Reader, contentType string) string {
 func PutJSON[T any](url string, payload any) (T, error) {
 	var result T
 
-	// Encode payload to JSON
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return result, err
-	}
-
-	respBody, err := PutBody(
-		url,
-		bytes.NewReader(data),
-		"application/json",
-	)
-	if err != nil {
-		return result, err
-	}
-
-	err = json.Unmarshal([]byte(respBody), &result)
+	resp, err := DefaultClient.Put(url).JSON(payload).Send()
 	if err != nil {
 		return result, err
 	}
 
-	return result, nil
+	err = resp.JSON(&result)
+	return result, err
 }
 
 // PutHeader sends an HTTP PUT request
 // and returns the response headers.
 func PutHeader(url string, body io.Reader, contentType string) (http.Header, error) {
-	req, err := http.NewRequest("PUT", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Content-Type", contentType)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := DefaultClient.Put(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
 	if err != nil {
 		return nil, err
 	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	return resp.Header, nil
+	return resp.Headers(), nil
 }
 
 // MustPutHeader sends an HTTP PUT request
 // and panics on error.
 func MustPutHeader(url string, body io.Reader, contentType string) http.Header {
-	headers, err := PutHeader(url, body, contentType)
-	if err != nil {
-		panic(err)
-	}
-	return headers
+	return Must(PutHeader(url, body, contentType))
 }
 
 // DELETE requests
@@ -370,45 +206,19 @@ func MustPutHeader(url string, body io.Reader, contentType string) http.Header {
 // with the provided body and content type,
 // and returns the response body as a string.
 func DeleteBody(url string, body io.Reader, contentType string) (string, error) {
-	req, err := http.NewRequest("DELETE", url, body)
-	if err != nil {
-		return "", err
-	}
-
-	req.Header.Set("Content-Type", contentType)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	respBody, err := io.ReadAll(resp.Body)
+	resp, err := DefaultClient.Delete(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
 	if err != nil {
 		return "", err
 	}
-
-	return string(respBody), nil
+	return resp.String(), nil
 }
 
 // MustDeleteBody sends an HTTP DELETE request and panics on error.
 func MustDeleteBody(url string, body io.Reader, contentType string) string {
-	result, err := DeleteBody(url, body, contentType)
-
-	if err != nil {
-		panic(err)
-	}
-
-	return result
+	return Must(DeleteBody(url, body, contentType))
 }
 
 // DeleteJSON sends an HTTP DELETE request with a JSON payload
@@ -416,63 +226,30 @@ func MustDeleteBody(url string, body io.Reader, contentType string) string {
 func DeleteJSON[T any](url string, payload any) (T, error) {
 	var result T
 
-	// Encode payload to JSON
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return result, err
-	}
-
-	respBody, err := DeleteBody(
-		url,
-		bytes.NewReader(data),
-		"application/json",
-	)
-	if err != nil {
-		return result, err
-	}
-
-	err = json.Unmarshal([]byte(respBody), &result)
+	resp, err := DefaultClient.Delete(url).JSON(payload).Send()
 	if err != nil {
 		return result, err
 	}
 
-	return result, nil
+	err = resp.JSON(&result)
+	return result, err
 }
 
 // DeleteHeader sends an HTTP DELETE request
 // and returns the response headers.
 func DeleteHeader(url string, body io.Reader, contentType string) (http.Header, error) {
-	req, err := http.NewRequest("DELETE", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Content-Type", contentType)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := DefaultClient.Delete(url).
+		Header("Content-Type", contentType).
+		bodyReader(body).
+		Send()
 	if err != nil {
 		return nil, err
 	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"status code: %d",
-			resp.StatusCode,
-		)
-	}
-
-	return resp.Header, nil
+	return resp.Headers(), nil
 }
 
 // MustDeleteHeader sends an HTTP DELETE request
 // and panics on error.
 func MustDeleteHeader(url string, body io.Reader, contentType string) http.Header {
-	headers, err := DeleteHeader(url, body, contentType)
-	if err != nil {
-		panic(err)
-	}
-	return headers
+	return Must(DeleteHeader(url, body, contentType))
 }
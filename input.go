@@ -9,12 +9,19 @@ import (
 )
 
 func inputRaw(prompt string) string {
+	return readLine(bufio.NewScanner(os.Stdin), prompt)
+}
+
+// readLine prompts and reads a single line from an existing scanner.
+// Callers that need to read more than one line in a row (retry loops)
+// must reuse the same scanner across calls: a fresh bufio.Scanner
+// over os.Stdin buffers ahead, so replacing it mid-stream silently
+// drops whatever it had already read but not yet handed back.
+func readLine(scanner *bufio.Scanner, prompt string) string {
 	if prompt != "" {
 		fmt.Print(prompt)
 	}
 
-	scanner := bufio.NewScanner(os.Stdin)
-
 	if !scanner.Scan() {
 		if err := scanner.Err(); err != nil {
 			panic(err)
@@ -28,10 +35,8 @@ func inputRaw(prompt string) string {
 func Input(prompt string, validators ...Validator) string {
 	input := inputRaw(prompt)
 
-	for _, validate := range validators {
-		if err := validate(input); err != nil {
-			panic(fmt.Errorf("invalid string input: %w", err))
-		}
+	if err := Validate(input, validators...); err != nil {
+		panic(fmt.Errorf("invalid string input: %w", err))
 	}
 
 	return input
@@ -64,3 +69,77 @@ func InputFloat(prompt string, defaultValue ...float64) float64 {
 
 	return value
 }
+
+//
+// NON-PANICKING VARIANTS (re-prompt on failure)
+//
+
+// TryInput reads a line and runs validators against it like Input,
+// but instead of panicking it prints the validation error and
+// re-prompts, up to retries additional attempts. It returns the
+// first input that passes, or an empty string along with the last
+// validation error once every attempt has failed.
+func TryInput(prompt string, retries int, validators ...Validator) (string, error) {
+	scanner := bufio.NewScanner(os.Stdin)
+	var lastErr error
+
+	for attempt := 0; attempt <= retries; attempt++ {
+		input := readLine(scanner, prompt)
+
+		if err := Validate(input, validators...); err != nil {
+			lastErr = err
+			fmt.Println(err)
+			continue
+		}
+
+		return input, nil
+	}
+
+	return "", lastErr
+}
+
+// TryInputInt reads a line and parses it as an integer, re-prompting
+// up to retries additional times on a parse failure instead of
+// panicking.
+func TryInputInt(prompt string, retries int) (int, error) {
+	scanner := bufio.NewScanner(os.Stdin)
+	var lastErr error
+
+	for attempt := 0; attempt <= retries; attempt++ {
+		input := readLine(scanner, prompt)
+
+		value, err := strconv.Atoi(input)
+		if err != nil {
+			lastErr = fmt.Errorf("invalid integer input: %q", input)
+			fmt.Println(lastErr)
+			continue
+		}
+
+		return value, nil
+	}
+
+	return 0, lastErr
+}
+
+// TryInputFloat reads a line and parses it as a float64, re-prompting
+// up to retries additional times on a parse failure instead of
+// panicking.
+func TryInputFloat(prompt string, retries int) (float64, error) {
+	scanner := bufio.NewScanner(os.Stdin)
+	var lastErr error
+
+	for attempt := 0; attempt <= retries; attempt++ {
+		input := readLine(scanner, prompt)
+
+		value, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			lastErr = fmt.Errorf("invalid float input: %q", input)
+			fmt.Println(lastErr)
+			continue
+		}
+
+		return value, nil
+	}
+
+	return 0, lastErr
+}
@@ -0,0 +1,49 @@
+package gosugar
+
+import (
+	"os"
+	"testing"
+)
+
+// withStdin temporarily replaces os.Stdin with a pipe fed with lines,
+// and restores the original afterwards.
+func withStdin(t *testing.T, lines string, fn func()) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	original := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = original }()
+
+	go func() {
+		w.WriteString(lines)
+		w.Close()
+	}()
+
+	fn()
+}
+
+func TestTryInputRetriesUntilValid(t *testing.T) {
+	withStdin(t, "\nhello\n", func() {
+		value, err := TryInput("", 2, NotEmpty())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value != "hello" {
+			t.Errorf("value = %q, want %q", value, "hello")
+		}
+	})
+}
+
+func TestTryInputReturnsErrorAfterExhaustingRetries(t *testing.T) {
+	withStdin(t, "\n\n\n", func() {
+		_, err := TryInput("", 2, NotEmpty())
+		if err == nil {
+			t.Fatal("expected an error after exhausting retries")
+		}
+	})
+}
@@ -2,11 +2,38 @@ package gosugar
 
 import (
 	"math/rand"
+	"sync"
 	"time"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
+// The package keeps a single shared *rand.Rand instead of seeding
+// the global math/rand source. UseRand lets tests swap it out for
+// a deterministic source (e.g. rand.New(rand.NewSource(1))).
+
+var (
+	randMu  sync.Mutex
+	randGen = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// UseRand replaces the package's random source. It exists so tests
+// can inject a deterministic *rand.Rand instead of relying on the
+// default time-seeded one.
+func UseRand(r *rand.Rand) {
+	randMu.Lock()
+	defer randMu.Unlock()
+	randGen = r
+}
+
+func randIntn(n int) int {
+	randMu.Lock()
+	defer randMu.Unlock()
+	return randGen.Intn(n)
+}
+
+func randFloat64() float64 {
+	randMu.Lock()
+	defer randMu.Unlock()
+	return randGen.Float64()
 }
 
 //
@@ -17,7 +44,7 @@ func RandInt(min, max int) int {
 	if min > max {
 		panic("min cannot be greater than max")
 	}
-	return rand.Intn(max-min+1) + min
+	return randIntn(max-min+1) + min
 }
 
 //
@@ -28,7 +55,7 @@ func RandFloat(min, max float64) float64 {
 	if min >= max {
 		panic("min must be less than max")
 	}
-	return min + rand.Float64()*(max-min)
+	return min + randFloat64()*(max-min)
 }
 
 //
@@ -36,7 +63,7 @@ func RandFloat(min, max float64) float64 {
 //
 
 func RandBool() bool {
-	return rand.Intn(2) == 1
+	return randIntn(2) == 1
 }
 
 //
@@ -47,7 +74,7 @@ func Choice[T any](items []T) T {
 	if len(items) == 0 {
 		panic("cannot choose from empty slice")
 	}
-	return items[rand.Intn(len(items))]
+	return items[randIntn(len(items))]
 }
 
 //
@@ -55,15 +82,89 @@ func Choice[T any](items []T) T {
 //
 
 func RandString(length int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	return RandStringFrom(length, letters)
+}
+
+//
+// RANDOM STRING FROM ALPHABET
+//
+
+func RandStringFrom(length int, alphabet string) string {
 	if length <= 0 {
 		panic("length must be positive")
 	}
-
-	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	if len(alphabet) == 0 {
+		panic("alphabet cannot be empty")
+	}
 
 	b := make([]byte, length)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		b[i] = alphabet[randIntn(len(alphabet))]
 	}
 	return string(b)
 }
+
+//
+// SHUFFLE (in place, Fisher-Yates)
+//
+
+func Shuffle[T any](items []T) {
+	for i := len(items) - 1; i > 0; i-- {
+		j := randIntn(i + 1)
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+//
+// SAMPLE (k distinct elements, without replacement)
+//
+
+func Sample[T any](items []T, k int) []T {
+	if k < 0 || k > len(items) {
+		panic("sample size out of range")
+	}
+
+	pool := make([]T, len(items))
+	copy(pool, items)
+	Shuffle(pool)
+
+	return pool[:k]
+}
+
+//
+// WEIGHTED CHOICE (cumulative weights, binary search)
+//
+
+func WeightedChoice[T any](items []T, weights []float64) T {
+	if len(items) == 0 || len(items) != len(weights) {
+		panic("items and weights must be the same non-zero length")
+	}
+
+	cumulative := make([]float64, len(weights))
+	total := 0.0
+	for i, w := range weights {
+		if w < 0 {
+			panic("weights must be non-negative")
+		}
+		total += w
+		cumulative[i] = total
+	}
+	if total <= 0 {
+		panic("total weight must be positive")
+	}
+
+	target := randFloat64() * total
+
+	lo, hi := 0, len(cumulative)-1
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if cumulative[mid] <= target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return items[lo]
+}
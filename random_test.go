@@ -0,0 +1,68 @@
+package gosugar
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestUseRandIsDeterministic(t *testing.T) {
+	UseRand(rand.New(rand.NewSource(1)))
+	a := RandInt(0, 1000)
+
+	UseRand(rand.New(rand.NewSource(1)))
+	b := RandInt(0, 1000)
+
+	if a != b {
+		t.Errorf("RandInt with the same seed diverged: %d != %d", a, b)
+	}
+}
+
+func TestShuffleKeepsElements(t *testing.T) {
+	UseRand(rand.New(rand.NewSource(1)))
+
+	items := []int{1, 2, 3, 4, 5}
+	shuffled := append([]int(nil), items...)
+	Shuffle(shuffled)
+
+	counts := map[int]int{}
+	for _, v := range shuffled {
+		counts[v]++
+	}
+	for _, v := range items {
+		if counts[v] != 1 {
+			t.Errorf("element %d appears %d times after Shuffle, want 1", v, counts[v])
+		}
+	}
+}
+
+func TestSampleSizeAndDistinctness(t *testing.T) {
+	UseRand(rand.New(rand.NewSource(1)))
+
+	items := []string{"a", "b", "c", "d", "e"}
+	sample := Sample(items, 3)
+
+	if len(sample) != 3 {
+		t.Fatalf("len(sample) = %d, want 3", len(sample))
+	}
+
+	seen := map[string]bool{}
+	for _, v := range sample {
+		if seen[v] {
+			t.Errorf("sample contains duplicate %q", v)
+		}
+		seen[v] = true
+	}
+}
+
+func TestWeightedChoiceAlwaysPicksTheOnlyPositiveWeight(t *testing.T) {
+	UseRand(rand.New(rand.NewSource(1)))
+
+	items := []string{"never", "always", "never2"}
+	weights := []float64{0, 1, 0}
+
+	for i := 0; i < 20; i++ {
+		if got := WeightedChoice(items, weights); got != "always" {
+			t.Fatalf("WeightedChoice = %q, want %q", got, "always")
+		}
+	}
+}
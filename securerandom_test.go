@@ -0,0 +1,51 @@
+package gosugar
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSecureRandBytesLength(t *testing.T) {
+	b := SecureRandBytes(16)
+	if len(b) != 16 {
+		t.Errorf("len = %d, want 16", len(b))
+	}
+}
+
+func TestSecureUUIDFormat(t *testing.T) {
+	id := SecureUUID()
+
+	parts := strings.Split(id, "-")
+	if len(parts) != 5 {
+		t.Fatalf("UUID %q has %d groups, want 5", id, len(parts))
+	}
+	if parts[2][0] != '4' {
+		t.Errorf("UUID %q is not version 4", id)
+	}
+}
+
+func TestSecurePasswordContainsAllRequestedClasses(t *testing.T) {
+	opts := PasswordOptions{Upper: true, Lower: true, Digit: true, Symbol: true}
+	pw := SecurePassword(24, opts)
+
+	if len(pw) != 24 {
+		t.Fatalf("len(password) = %d, want 24", len(pw))
+	}
+
+	classes := []string{upperAlphabet, lowerAlphabet, digitAlphabet, symbolAlphabet}
+	for _, class := range classes {
+		if !strings.ContainsAny(pw, class) {
+			t.Errorf("password %q is missing a character from class %q", pw, class)
+		}
+	}
+}
+
+func TestSecurePasswordPanicsWhenTooShortForClasses(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic when length is smaller than the number of required classes")
+		}
+	}()
+
+	SecurePassword(2, PasswordOptions{Upper: true, Lower: true, Digit: true, Symbol: true})
+}
@@ -0,0 +1,67 @@
+package serve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJSONWritesStatusAndBody(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	if err := JSON(rec, http.StatusCreated, map[string]string{"hello": "world"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusCreated)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/json")
+	}
+	if got := strings.TrimSpace(rec.Body.String()); got != `{"hello":"world"}` {
+		t.Errorf("body = %q, want %q", got, `{"hello":"world"}`)
+	}
+}
+
+func TestTextWritesStatusAndBody(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	if err := Text(rec, http.StatusOK, "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q, want %q", ct, "text/plain; charset=utf-8")
+	}
+	if rec.Body.String() != "hello" {
+		t.Errorf("body = %q, want %q", rec.Body.String(), "hello")
+	}
+}
+
+func TestBindDecodesJSONBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"ada"}`))
+
+	var payload struct {
+		Name string `json:"name"`
+	}
+	if err := Bind(req, &payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Name != "ada" {
+		t.Errorf("Name = %q, want %q", payload.Name, "ada")
+	}
+}
+
+func TestBindReturnsErrorOnInvalidJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`not json`))
+
+	var payload struct{}
+	if err := Bind(req, &payload); err == nil {
+		t.Error("expected an error decoding invalid JSON")
+	}
+}
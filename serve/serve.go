@@ -0,0 +1,276 @@
+// Package serve complements the gosugar HTTP client with an equally
+// terse *server* API: a one-line static file server, and JSON/Text/
+// Bind helpers for small REST endpoints.
+package serve
+
+import (
+	"compress/gzip"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Serve starts a static file server for dir on addr. It's the
+// one-liner entry point; use NewFileServer directly for anything
+// more than default behavior.
+func Serve(addr, dir string) error {
+	return http.ListenAndServe(addr, NewFileServer(dir))
+}
+
+// FileServer serves files out of Dir, with options for directory
+// browsing, hidden files, SPA fallback, ETags and gzip negotiation.
+type FileServer struct {
+	Dir string
+
+	// IndexFiles are tried, in order, when a directory is requested.
+	IndexFiles []string
+
+	// Browse auto-generates an HTML directory listing when a
+	// directory has no matching IndexFiles entry.
+	Browse bool
+
+	// HiddenFiles holds filepath.Match patterns; matching entries
+	// are excluded from directory listings and return 404 when
+	// requested directly.
+	HiddenFiles []string
+
+	// SPAFallback rewrites unknown paths to this file (typically
+	// "index.html") instead of returning 404, for single-page apps.
+	SPAFallback string
+
+	// ETag sets a weak ETag from each file's mtime and size, and
+	// honors If-None-Match with a 304.
+	ETag bool
+
+	// Gzip compresses responses on the fly when the client sends
+	// Accept-Encoding: gzip. The standard library has no brotli
+	// encoder, so "br" is accepted in Accept-Encoding but not
+	// negotiated.
+	Gzip bool
+}
+
+// NewFileServer returns a FileServer for dir with index.html as the
+// default index file.
+func NewFileServer(dir string) *FileServer {
+	return &FileServer{
+		Dir:        dir,
+		IndexFiles: []string{"index.html"},
+	}
+}
+
+func (fs *FileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	urlPath := r.URL.Path
+	if !strings.HasPrefix(urlPath, "/") {
+		urlPath = "/" + urlPath
+	}
+	urlPath = path.Clean(urlPath)
+
+	if fs.isHiddenPath(urlPath) {
+		http.NotFound(w, r)
+		return
+	}
+
+	fullPath := filepath.Join(fs.Dir, filepath.FromSlash(urlPath))
+
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		if fs.SPAFallback != "" {
+			fallback := filepath.Join(fs.Dir, filepath.FromSlash(fs.SPAFallback))
+			if fbInfo, fbErr := os.Stat(fallback); fbErr == nil {
+				fs.serveFile(w, r, fallback, fbInfo)
+				return
+			}
+		}
+		http.NotFound(w, r)
+		return
+	}
+
+	if info.IsDir() {
+		if indexPath, indexInfo, ok := fs.findIndex(fullPath); ok {
+			fs.serveFile(w, r, indexPath, indexInfo)
+			return
+		}
+		if fs.Browse {
+			fs.serveDirListing(w, r, fullPath, urlPath)
+			return
+		}
+		http.NotFound(w, r)
+		return
+	}
+
+	fs.serveFile(w, r, fullPath, info)
+}
+
+func (fs *FileServer) findIndex(dir string) (string, os.FileInfo, bool) {
+	for _, name := range fs.IndexFiles {
+		p := filepath.Join(dir, name)
+		if info, err := os.Stat(p); err == nil && !info.IsDir() {
+			return p, info, true
+		}
+	}
+	return "", nil, false
+}
+
+func (fs *FileServer) isHidden(name string) bool {
+	for _, pattern := range fs.HiddenFiles {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// isHiddenPath reports whether any segment of urlPath (not just the
+// last one) matches HiddenFiles, so a pattern like ".git" also hides
+// everything underneath it (e.g. "/.git/config").
+func (fs *FileServer) isHiddenPath(urlPath string) bool {
+	for _, segment := range strings.Split(urlPath, "/") {
+		if segment == "" {
+			continue
+		}
+		if fs.isHidden(segment) {
+			return true
+		}
+	}
+	return false
+}
+
+func (fs *FileServer) serveFile(w http.ResponseWriter, r *http.Request, fullPath string, info os.FileInfo) {
+	if fs.ETag {
+		etag := fmt.Sprintf(`W/"%x-%x"`, info.ModTime().Unix(), info.Size())
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	if fs.Gzip && strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		f, err := os.Open(fullPath)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+
+		if ct := mime.TypeByExtension(filepath.Ext(fullPath)); ct != "" {
+			w.Header().Set("Content-Type", ct)
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+
+		io.Copy(gz, f)
+		return
+	}
+
+	http.ServeFile(w, r, fullPath)
+}
+
+func (fs *FileServer) serveDirListing(w http.ResponseWriter, r *http.Request, dir, urlPath string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type row struct {
+		name    string
+		isDir   bool
+		size    int64
+		modTime time.Time
+	}
+
+	rows := make([]row, 0, len(entries))
+	for _, e := range entries {
+		if fs.isHidden(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		rows = append(rows, row{name: e.Name(), isDir: e.IsDir(), size: info.Size(), modTime: info.ModTime()})
+	}
+
+	sortBy := r.URL.Query().Get("sort")
+	order := r.URL.Query().Get("order")
+
+	sort.Slice(rows, func(i, j int) bool {
+		var less bool
+		switch sortBy {
+		case "size":
+			less = rows[i].size < rows[j].size
+		case "modtime":
+			less = rows[i].modTime.Before(rows[j].modTime)
+		default:
+			less = rows[i].name < rows[j].name
+		}
+		if order == "desc" {
+			return !less
+		}
+		return less
+	})
+
+	nextOrder := "asc"
+	if order == "asc" {
+		nextOrder = "desc"
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	fmt.Fprintf(w, "<html><head><title>Index of %s</title></head><body>\n", html.EscapeString(urlPath))
+	fmt.Fprintf(w, "<h1>Index of %s</h1>\n<table>\n", html.EscapeString(urlPath))
+	fmt.Fprintf(w,
+		"<tr><th><a href=\"?sort=name&order=%[1]s\">Name</a></th>"+
+			"<th><a href=\"?sort=size&order=%[1]s\">Size</a></th>"+
+			"<th><a href=\"?sort=modtime&order=%[1]s\">Modified</a></th></tr>\n",
+		nextOrder,
+	)
+
+	if urlPath != "/" {
+		fmt.Fprint(w, "<tr><td><a href=\"../\">../</a></td><td></td><td></td></tr>\n")
+	}
+
+	for _, e := range rows {
+		href := e.name
+		name := e.name
+		size := humanSize(e.size)
+		if e.isDir {
+			href += "/"
+			name += "/"
+			size = "-"
+		}
+
+		fmt.Fprintf(w, "<tr><td><a href=\"%s\">%s</a></td><td>%s</td><td>%s</td></tr>\n",
+			html.EscapeString(href), html.EscapeString(name), size, e.modTime.Format("2006-01-02 15:04:05"))
+	}
+
+	fmt.Fprint(w, "</table></body></html>")
+}
+
+// humanSize formats n bytes as a short human-readable size, e.g. "4.2 KB".
+func humanSize(n int64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for n/div >= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,212 @@
+package serve
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileServerHidesNestedPath(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(dir, ".git"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".git", "config"), []byte("secret"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileServer(dir)
+	fs.HiddenFiles = []string{".git"}
+
+	req := httptest.NewRequest(http.MethodGet, "/.git/config", nil)
+	rec := httptest.NewRecorder()
+
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d; body = %q", rec.Code, http.StatusNotFound, rec.Body.String())
+	}
+}
+
+func TestFileServerServesVisibleFile(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileServer(dir)
+	fs.HiddenFiles = []string{".git"}
+
+	req := httptest.NewRequest(http.MethodGet, "/hello.txt", nil)
+	rec := httptest.NewRecorder()
+
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.String() != "hi" {
+		t.Errorf("body = %q, want %q", rec.Body.String(), "hi")
+	}
+}
+
+func TestFileServerBrowseListsAndSorts(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "b.txt"), []byte("bb"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileServer(dir)
+	fs.Browse = true
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	body := rec.Body.String()
+	if strings.Index(body, "a.txt") > strings.Index(body, "b.txt") {
+		t.Errorf("default listing not sorted by name ascending: %s", body)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/?sort=size&order=desc", nil)
+	rec = httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	body = rec.Body.String()
+	if strings.Index(body, "b.txt") > strings.Index(body, "a.txt") {
+		t.Errorf("sort=size&order=desc listing not sorted largest-first: %s", body)
+	}
+}
+
+func TestFileServerBrowseDisabledReturnsNotFound(t *testing.T) {
+	dir := t.TempDir()
+
+	fs := NewFileServer(dir)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestFileServerSPAFallback(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("<app/>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileServer(dir)
+	fs.SPAFallback = "index.html"
+
+	req := httptest.NewRequest(http.MethodGet, "/some/client/route", nil)
+	rec := httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.String() != "<app/>" {
+		t.Errorf("body = %q, want %q", rec.Body.String(), "<app/>")
+	}
+}
+
+func TestFileServerSPAFallbackDisabledReturnsNotFound(t *testing.T) {
+	dir := t.TempDir()
+
+	fs := NewFileServer(dir)
+
+	req := httptest.NewRequest(http.MethodGet, "/some/client/route", nil)
+	rec := httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestFileServerETagAndConditionalGet(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileServer(dir)
+	fs.ETag = true
+
+	req := httptest.NewRequest(http.MethodGet, "/hello.txt", nil)
+	rec := httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected an ETag header")
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/hello.txt", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+}
+
+func TestFileServerGzipNegotiation(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "hello.txt"), []byte("hello world"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileServer(dir)
+	fs.Gzip = true
+
+	req := httptest.NewRequest(http.MethodGet, "/hello.txt", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	fs.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want %q", rec.Header().Get("Content-Encoding"), "gzip")
+	}
+
+	gz, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("response body is not valid gzip: %v", err)
+	}
+	defer gz.Close()
+
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("failed to decompress body: %v", err)
+	}
+	if string(data) != "hello world" {
+		t.Errorf("decompressed body = %q, want %q", string(data), "hello world")
+	}
+}
@@ -1,16 +1,83 @@
 package gosugar
 
 import (
-	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 type Validator func(string) error
 
+//
+// VALIDATION ERRORS
+//
+
+// ValidationError describes a single failed validation rule.
+// Field is left empty by Validate, which only knows about a bare
+// value; callers building form-style validation on top of this
+// package can set it themselves.
+type ValidationError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+// ValidationErrors aggregates every failed rule from a single
+// Validate call, instead of stopping at the first failure.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate runs every validator against value and returns a
+// ValidationErrors aggregating all failures, or nil if every
+// validator passed.
+func Validate(value string, validators ...Validator) error {
+	var errs ValidationErrors
+
+	for _, validate := range validators {
+		if err := validate(value); err != nil {
+			if ve, ok := err.(ValidationError); ok {
+				errs = append(errs, ve)
+			} else {
+				errs = append(errs, ValidationError{Message: err.Error()})
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func ruleErr(rule, message string) error {
+	return ValidationError{Rule: rule, Message: message}
+}
+
+//
+// BASIC VALIDATORS
+//
+
 func NotEmpty() Validator {
 	return func(s string) error {
 		if s == "" {
-			return errors.New("value cannot be empty")
+			return ruleErr("not_empty", "value cannot be empty")
 		}
 		return nil
 	}
@@ -19,7 +86,7 @@ func NotEmpty() Validator {
 func MinLen(n int) Validator {
 	return func(s string) error {
 		if len(s) < n {
-			return fmt.Errorf("minimum length is %d", n)
+			return ruleErr("min_len", fmt.Sprintf("minimum length is %d", n))
 		}
 		return nil
 	}
@@ -28,8 +95,158 @@ func MinLen(n int) Validator {
 func MaxLen(n int) Validator {
 	return func(s string) error {
 		if len(s) > n {
-			return fmt.Errorf("maximum length is %d", n)
+			return ruleErr("max_len", fmt.Sprintf("maximum length is %d", n))
+		}
+		return nil
+	}
+}
+
+//
+// FORMAT VALIDATORS
+//
+
+var emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+func Email() Validator {
+	return func(s string) error {
+		if !emailPattern.MatchString(s) {
+			return ruleErr("email", "must be a valid email address")
+		}
+		return nil
+	}
+}
+
+func URL() Validator {
+	return func(s string) error {
+		u, err := url.ParseRequestURI(s)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return ruleErr("url", "must be a valid URL")
+		}
+		return nil
+	}
+}
+
+func Regex(pattern string) Validator {
+	re := regexp.MustCompile(pattern)
+	return func(s string) error {
+		if !re.MatchString(s) {
+			return ruleErr("regex", fmt.Sprintf("must match pattern %q", pattern))
 		}
 		return nil
 	}
 }
+
+func OneOf(vals ...string) Validator {
+	return func(s string) error {
+		for _, v := range vals {
+			if s == v {
+				return nil
+			}
+		}
+		return ruleErr("one_of", fmt.Sprintf("must be one of %v", vals))
+	}
+}
+
+func IntRange(min, max int) Validator {
+	return func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return ruleErr("int_range", "must be an integer")
+		}
+		if n < min || n > max {
+			return ruleErr("int_range", fmt.Sprintf("must be between %d and %d", min, max))
+		}
+		return nil
+	}
+}
+
+var alphaPattern = regexp.MustCompile(`^[A-Za-z]+$`)
+
+func Alpha() Validator {
+	return func(s string) error {
+		if !alphaPattern.MatchString(s) {
+			return ruleErr("alpha", "must contain letters only")
+		}
+		return nil
+	}
+}
+
+var alphanumericPattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+func Alphanumeric() Validator {
+	return func(s string) error {
+		if !alphanumericPattern.MatchString(s) {
+			return ruleErr("alphanumeric", "must contain letters and digits only")
+		}
+		return nil
+	}
+}
+
+func NoWhitespace() Validator {
+	return func(s string) error {
+		if strings.ContainsAny(s, " \t\n\r\v\f") {
+			return ruleErr("no_whitespace", "must not contain whitespace")
+		}
+		return nil
+	}
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func IsUUID() Validator {
+	return func(s string) error {
+		if !uuidPattern.MatchString(s) {
+			return ruleErr("is_uuid", "must be a valid UUID")
+		}
+		return nil
+	}
+}
+
+func IsIP() Validator {
+	return func(s string) error {
+		if net.ParseIP(s) == nil {
+			return ruleErr("is_ip", "must be a valid IP address")
+		}
+		return nil
+	}
+}
+
+//
+// COMBINATORS
+//
+
+// All returns a Validator that passes only if every one of
+// validators passes, aggregating their errors like Validate does.
+func All(validators ...Validator) Validator {
+	return func(s string) error {
+		return Validate(s, validators...)
+	}
+}
+
+// Any returns a Validator that passes if at least one of
+// validators passes.
+func Any(validators ...Validator) Validator {
+	return func(s string) error {
+		if len(validators) == 0 {
+			return nil
+		}
+
+		for _, validate := range validators {
+			if validate(s) == nil {
+				return nil
+			}
+		}
+		return ruleErr("any", "must satisfy at least one condition")
+	}
+}
+
+// Not inverts a Validator: it passes when v fails and fails when
+// v passes.
+func Not(v Validator) Validator {
+	return func(s string) error {
+		if v(s) != nil {
+			return nil
+		}
+		return ruleErr("not", "must not satisfy the given condition")
+	}
+}
@@ -0,0 +1,75 @@
+package gosugar
+
+import "testing"
+
+func TestValidateAggregatesAllFailures(t *testing.T) {
+	err := Validate("", NotEmpty(), MinLen(5))
+	if err == nil {
+		t.Fatal("expected an aggregated error")
+	}
+
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("error is %T, want ValidationErrors", err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("len(errs) = %d, want 2", len(errs))
+	}
+}
+
+func TestValidatePassesWhenAllValidatorsPass(t *testing.T) {
+	if err := Validate("hello", NotEmpty(), MinLen(3)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFormatValidators(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator Validator
+		value     string
+		wantOK    bool
+	}{
+		{"email ok", Email(), "a@b.com", true},
+		{"email bad", Email(), "not-an-email", false},
+		{"url ok", URL(), "https://example.com", true},
+		{"url bad", URL(), "not a url", false},
+		{"one_of ok", OneOf("a", "b"), "b", true},
+		{"one_of bad", OneOf("a", "b"), "c", false},
+		{"int_range ok", IntRange(1, 10), "5", true},
+		{"int_range bad", IntRange(1, 10), "50", false},
+		{"alpha ok", Alpha(), "abc", true},
+		{"alpha bad", Alpha(), "abc1", false},
+		{"is_uuid ok", IsUUID(), "123e4567-e89b-42d3-a456-426614174000", true},
+		{"is_uuid bad", IsUUID(), "not-a-uuid", false},
+		{"is_ip ok", IsIP(), "127.0.0.1", true},
+		{"is_ip bad", IsIP(), "not-an-ip", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.validator(c.value)
+			if (err == nil) != c.wantOK {
+				t.Errorf("validator(%q) error = %v, want ok=%v", c.value, err, c.wantOK)
+			}
+		})
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	if err := All(NotEmpty(), MinLen(2))("ab"); err != nil {
+		t.Errorf("All: unexpected error: %v", err)
+	}
+	if err := All(NotEmpty(), MinLen(2))("a"); err == nil {
+		t.Error("All: expected an error")
+	}
+	if err := Any(Email(), IsIP())("127.0.0.1"); err != nil {
+		t.Errorf("Any: unexpected error: %v", err)
+	}
+	if err := Any(Email(), IsIP())("nope"); err == nil {
+		t.Error("Any: expected an error")
+	}
+	if err := Not(NotEmpty())(""); err != nil {
+		t.Errorf("Not: unexpected error: %v", err)
+	}
+}